@@ -30,7 +30,7 @@ type response struct {
 	Method  string            `json:"method"`
 	Path    string            `json:"path"`
 	Headers map[string]string `json:"headers,omitempty"`
-	Query   map[string]string `json:"query,omitempty"`
+	Query   map[string]any    `json:"query,omitempty"`
 	Body    any               `json:"body,omitempty,omitzero"`
 }
 
@@ -58,9 +58,13 @@ func handler(w http.ResponseWriter, r *http.Request) {
 		headers[key] = r.Header.Get(key)
 	}
 
-	query := map[string]string{}
-	for key := range r.URL.Query() {
-		query[key] = r.URL.Query().Get(key)
+	query := map[string]any{}
+	for key, values := range r.URL.Query() {
+		if len(values) == 1 {
+			query[key] = values[0]
+		} else {
+			query[key] = values
+		}
 	}
 
 	var resp any
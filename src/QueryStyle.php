@@ -0,0 +1,33 @@
+<?php
+
+declare(strict_types=1);
+
+namespace Pugkong\Symfony\Requests;
+
+/**
+ * Serialization style of list-valued query parameters.
+ *
+ * Except for the indexed style, list items must be scalars. Empty lists are omitted in every style.
+ */
+enum QueryStyle
+{
+    /**
+     * PHP style with indexes, e.g. `tags[0]=a&tags[1]=b` (symfony/http-client default).
+     */
+    case Indexed;
+
+    /**
+     * PHP style without indexes, e.g. `tags[]=a&tags[]=b`.
+     */
+    case Brackets;
+
+    /**
+     * Repeated keys, e.g. `tags=a&tags=b`.
+     */
+    case Repeat;
+
+    /**
+     * Comma separated values, e.g. `tags=a,b`. Commas inside items are encoded as `%2C`.
+     */
+    case Comma;
+}
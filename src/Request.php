@@ -5,17 +5,28 @@ declare(strict_types=1);
 namespace Pugkong\Symfony\Requests;
 
 use Closure;
+use InvalidArgumentException;
 use LogicException;
 use SensitiveParameter;
 use Symfony\Component\Serializer\SerializerInterface;
 use Symfony\Contracts\HttpClient\HttpClientInterface;
 
+use function is_array;
+use function is_bool;
+use function is_int;
+use function is_scalar;
+use function is_string;
+use function sprintf;
+
+use const PHP_QUERY_RFC3986;
+
 /**
  * Represents a configurable HTTP request.
  *
  * @phpstan-type TOptions array<string, mixed>&array{
  *     vars?: array<string, mixed>,
- *     headers?: array<string, string|string[]>,
+ *     headers?: array<array-key, string|string[]>,
+ *     query?: array<string, mixed>,
  * }
  */
 final readonly class Request
@@ -31,6 +42,7 @@ final readonly class Request
         public array $options,
         public ?string $method = null,
         public ?string $path = null,
+        public QueryStyle $queryStyle = QueryStyle::Indexed,
     ) {
     }
 
@@ -181,14 +193,36 @@ final readonly class Request
         return $this->headers([$key => $value]);
     }
 
+    /**
+     * Removes a request header, including one inherited from a base request.
+     *
+     * Both the associative (`['Name' => 'value']`) and the list (`['Name: value']`)
+     * header forms of symfony/http-client are supported.
+     *
+     * @param string $key Header name (case-insensitive)
+     */
+    public function removeHeader(string $key): self
+    {
+        $headers = [];
+        foreach ($this->options['headers'] ?? [] as $name => $value) {
+            $headerName = is_int($name) && is_string($value) ? explode(':', $value, 2)[0] : (string) $name;
+            if (0 !== strcasecmp(trim($headerName), $key)) {
+                $headers[$name] = $value;
+            }
+        }
+
+        return $this->with(['headers' => $headers]);
+    }
+
     /**
      * Sets the query parameters for the request.
      *
-     * @param array<string, string> $query Query parameters
+     * @param array<string, mixed> $query Query parameters
+     * @param QueryStyle           $style Serialization style of list values
      */
-    public function query(array $query): self
+    public function query(array $query, QueryStyle $style = QueryStyle::Indexed): self
     {
-        return $this->with(['query' => $query]);
+        return $this->with(['query' => $query], queryStyle: $style);
     }
 
     /**
@@ -250,12 +284,23 @@ final readonly class Request
             throw new LogicException('The HTTP method and path were not set');
         }
 
+        $path = $this->path;
+        $options = $this->options;
+        if (QueryStyle::Indexed !== $this->queryStyle) {
+            $query = self::buildQuery($options['query'] ?? [], $this->queryStyle);
+            if ('' !== $query) {
+                $path .= (str_contains($path, '?') ? '&' : '?').$query;
+            }
+
+            unset($options['query']);
+        }
+
         return new Response(
             http: $this->http,
             serializer: $this->serializer,
             format: $this->responseFormat,
             request: $this,
-            inner: $this->http->request($this->method, $this->path, $this->options),
+            inner: $this->http->request($this->method, $path, $options),
         );
     }
 
@@ -266,6 +311,7 @@ final readonly class Request
         ?array $options = null,
         ?string $method = null,
         ?string $path = null,
+        ?QueryStyle $queryStyle = null,
     ): self {
         return new self(
             http: $this->http,
@@ -275,6 +321,50 @@ final readonly class Request
             options: $options ? array_merge($this->options, $options) : $this->options,
             method: $method ?? $this->method,
             path: $path ?? $this->path,
+            queryStyle: $queryStyle ?? $this->queryStyle,
         );
     }
+
+    /**
+     * @param array<string, mixed> $query
+     *
+     * @throws InvalidArgumentException If a list contains non-scalar items
+     */
+    private static function buildQuery(array $query, QueryStyle $style): string
+    {
+        $parts = [];
+        foreach ($query as $key => $value) {
+            if (QueryStyle::Indexed === $style || !is_array($value) || !array_is_list($value)) {
+                $parts[] = http_build_query([$key => $value], '', '&', PHP_QUERY_RFC3986);
+
+                continue;
+            }
+
+            $items = [];
+            foreach ($value as $item) {
+                if (!is_scalar($item)) {
+                    throw new InvalidArgumentException(sprintf(
+                        'The "%s" query parameter list must contain only scalars for the %s style',
+                        $key,
+                        $style->name,
+                    ));
+                }
+
+                $items[] = rawurlencode(is_bool($item) ? (string) (int) $item : (string) $item);
+            }
+
+            if ([] === $items) {
+                continue;
+            }
+
+            $name = rawurlencode((string) $key);
+            $parts[] = match ($style) {
+                QueryStyle::Brackets => $name.'%5B%5D='.implode('&'.$name.'%5B%5D=', $items),
+                QueryStyle::Repeat => $name.'='.implode('&'.$name.'=', $items),
+                QueryStyle::Comma => $name.'='.implode(',', $items),
+            };
+        }
+
+        return implode('&', array_filter($parts));
+    }
 }
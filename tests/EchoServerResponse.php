@@ -7,9 +7,9 @@ namespace Pugkong\Symfony\Requests\Tests;
 final readonly class EchoServerResponse
 {
     /**
-     * @param array<string, string>|null $headers
-     * @param array<string, string>|null $query
-     * @param array<string, mixed>|null  $body
+     * @param array<string, string>|null          $headers
+     * @param array<string, string|string[]>|null $query
+     * @param array<string, mixed>|null           $body
      */
     public function __construct(
         public string $method,
@@ -21,9 +21,9 @@ final readonly class EchoServerResponse
     }
 
     /**
-     * @param array<string, string>      $headers
-     * @param array<string, string>|null $query
-     * @param array<string, mixed>|null  $body
+     * @param array<string, string>               $headers
+     * @param array<string, string|string[]>|null $query
+     * @param array<string, mixed>|null           $body
      */
     public static function json(
         string $method,
@@ -49,9 +49,9 @@ final readonly class EchoServerResponse
     }
 
     /**
-     * @param array<string, string>      $headers
-     * @param array<string, string>|null $query
-     * @param array<string, mixed>|null  $body
+     * @param array<string, string>               $headers
+     * @param array<string, string|string[]>|null $query
+     * @param array<string, mixed>|null           $body
      */
     public static function xml(
         string $method,
@@ -4,10 +4,12 @@ declare(strict_types=1);
 
 namespace Pugkong\Symfony\Requests\Tests;
 
+use InvalidArgumentException;
 use LogicException;
 use PHPUnit\Framework\Attributes\DataProvider;
 use PHPUnit\Framework\TestCase;
 use Pugkong\Symfony\Requests\FormEncoder;
+use Pugkong\Symfony\Requests\QueryStyle;
 use Pugkong\Symfony\Requests\Request;
 use Pugkong\Symfony\Requests\StatusCodeException;
 use Symfony\Component\HttpClient\HttpClient;
@@ -21,10 +23,16 @@ use Symfony\Contracts\HttpClient\ChunkInterface;
 
 use const ARRAY_FILTER_USE_KEY;
 
+/**
+ * @phpstan-import-type TOptions from Request
+ */
 final class RequestTest extends TestCase
 {
+    /**
+     * @param TOptions $options
+     */
     #[DataProvider('requestProvider')]
-    public function testRequest(mixed $expected, callable $fetch): void
+    public function testRequest(mixed $expected, callable $fetch, array $options = []): void
     {
         if ($expected instanceof ExpectedException) {
             $this->expectException($expected->class);
@@ -38,7 +46,7 @@ final class RequestTest extends TestCase
             encoders: [new JsonEncoder(), new XmlEncoder(), new FormEncoder()],
         );
 
-        $request = Request::create($http, $serializer)
+        $request = Request::create($http, $serializer, options: $options)
             ->base('http://localhost:8000')
             ->header('User-Agent', 'symfony-requests')
         ;
@@ -215,6 +223,56 @@ final class RequestTest extends TestCase
                     ->object(EchoServerResponse::class),
             ],
 
+            'override default header' => [
+                EchoServerResponse::json(
+                    method: 'GET',
+                    path: '/headers',
+                    headers: ['User-Agent' => 'custom'],
+                ),
+                fn (Request $request) => $request
+                    ->get('/headers')
+                    ->header('Accept', 'application/json')
+                    ->header('User-Agent', 'custom')
+                    ->response()
+                    ->checkStatus(200)
+                    ->object(EchoServerResponse::class),
+            ],
+
+            'remove header' => [
+                EchoServerResponse::json(
+                    method: 'GET',
+                    path: '/headers',
+                    headers: ['Accept-Language' => 'en'],
+                ),
+                fn (Request $request) => $request
+                    ->get('/headers')
+                    ->headers([
+                        'Accept' => 'application/json',
+                        'Accept-Language' => 'en',
+                        'Cache-Control' => 'no-cache',
+                    ])
+                    ->removeHeader('cache-control')
+                    ->response()
+                    ->checkStatus(200)
+                    ->object(EchoServerResponse::class),
+            ],
+
+            'remove default header in list form' => [
+                EchoServerResponse::json(
+                    method: 'GET',
+                    path: '/headers',
+                    headers: ['Accept-Language' => 'en'],
+                ),
+                fn (Request $request) => $request
+                    ->get('/headers')
+                    ->header('Accept', 'application/json')
+                    ->removeHeader('Cache-Control')
+                    ->response()
+                    ->checkStatus(200)
+                    ->object(EchoServerResponse::class),
+                ['headers' => ['Accept-Language: en', 'Cache-Control: no-cache']],
+            ],
+
             'query parameters' => [
                 EchoServerResponse::json(method: 'GET', path: '/query', query: ['name' => 'John Doe']),
                 fn (Request $request) => $request
@@ -226,6 +284,138 @@ final class RequestTest extends TestCase
                     ->object(EchoServerResponse::class),
             ],
 
+            'query parameter list' => [
+                EchoServerResponse::json(
+                    method: 'GET',
+                    path: '/query',
+                    query: ['tags[0]' => 'a', 'tags[1]' => 'b'],
+                ),
+                fn (Request $request) => $request
+                    ->get('/query')
+                    ->header('Accept', 'application/json')
+                    ->query(['tags' => ['a', 'b']])
+                    ->response()
+                    ->checkStatus(200)
+                    ->object(EchoServerResponse::class),
+            ],
+
+            'query parameter list with brackets' => [
+                EchoServerResponse::json(
+                    method: 'GET',
+                    path: '/query',
+                    query: ['name' => 'John Doe', 'tags[]' => ['a', 'b']],
+                ),
+                fn (Request $request) => $request
+                    ->get('/query')
+                    ->header('Accept', 'application/json')
+                    ->query(['name' => 'John Doe', 'tags' => ['a', 'b']], QueryStyle::Brackets)
+                    ->response()
+                    ->checkStatus(200)
+                    ->object(EchoServerResponse::class),
+            ],
+
+            'query parameter list with repeated keys' => [
+                EchoServerResponse::json(
+                    method: 'GET',
+                    path: '/query',
+                    query: ['name' => 'John Doe', 'tags' => ['a', 'b']],
+                ),
+                fn (Request $request) => $request
+                    ->get('/query?name={name}')
+                    ->var('name', 'John Doe')
+                    ->header('Accept', 'application/json')
+                    ->query(['tags' => ['a', 'b']], QueryStyle::Repeat)
+                    ->response()
+                    ->checkStatus(200)
+                    ->object(EchoServerResponse::class),
+            ],
+
+            'query parameter list with commas' => [
+                EchoServerResponse::json(
+                    method: 'GET',
+                    path: '/query',
+                    query: ['name' => 'John Doe', 'tags' => 'a,b'],
+                ),
+                fn (Request $request) => $request
+                    ->get('/query')
+                    ->header('Accept', 'application/json')
+                    ->query(['name' => 'John Doe', 'tags' => ['a', 'b']], QueryStyle::Comma)
+                    ->response()
+                    ->checkStatus(200)
+                    ->object(EchoServerResponse::class),
+            ],
+
+            'query parameter empty list' => [
+                EchoServerResponse::json(
+                    method: 'GET',
+                    path: '/query',
+                    query: ['name' => 'John Doe'],
+                ),
+                fn (Request $request) => $request
+                    ->get('/query')
+                    ->header('Accept', 'application/json')
+                    ->query(['name' => 'John Doe', 'tags' => []], QueryStyle::Comma)
+                    ->response()
+                    ->checkStatus(200)
+                    ->object(EchoServerResponse::class),
+            ],
+
+            'query parameters set twice with different styles' => [
+                EchoServerResponse::json(method: 'GET', path: '/query', query: ['ids[0]' => '1', 'ids[1]' => '2']),
+                fn (Request $request) => $request
+                    ->get('/query')
+                    ->header('Accept', 'application/json')
+                    ->query(['tags' => ['a', 'b']], QueryStyle::Repeat)
+                    ->query(['ids' => ['1', '2']])
+                    ->response()
+                    ->checkStatus(200)
+                    ->object(EchoServerResponse::class),
+            ],
+
+            'query parameter style replaces base query' => [
+                EchoServerResponse::json(method: 'GET', path: '/query', query: ['tags' => ['a', 'b']]),
+                fn (Request $request) => $request
+                    ->get('/query')
+                    ->header('Accept', 'application/json')
+                    ->query(['tags' => ['a', 'b']], QueryStyle::Repeat)
+                    ->response()
+                    ->checkStatus(200)
+                    ->object(EchoServerResponse::class),
+                ['query' => ['page' => '1']],
+            ],
+
+            'query parameter commas inside items' => [
+                'http://localhost:8000/query?tags=a,b%2Cc',
+                fn (Request $request) => $request
+                    ->get('/query')
+                    ->header('Accept', 'application/json')
+                    ->query(['tags' => ['a', 'b,c']], QueryStyle::Comma)
+                    ->response()
+                    ->checkStatus(200)
+                    ->inner
+                    ->getInfo('url'),
+            ],
+
+            'query parameter style is kept with the options' => [
+                [['tags' => ['a', 'b']], QueryStyle::Repeat],
+                function (Request $request) {
+                    $request = $request->query(['tags' => ['a', 'b']], QueryStyle::Repeat);
+
+                    return [$request->options['query'] ?? null, $request->queryStyle];
+                },
+            ],
+
+            'query parameter list with non-scalar items' => [
+                new ExpectedException(
+                    class: InvalidArgumentException::class,
+                    message: 'The "tags" query parameter list must contain only scalars for the Comma style',
+                ),
+                fn (Request $request) => $request
+                    ->get('/query')
+                    ->query(['tags' => [['a']]], QueryStyle::Comma)
+                    ->response(),
+            ],
+
             'auth basic' => [
                 EchoServerResponse::json(
                     method: 'GET',